@@ -29,11 +29,35 @@ import (
 
 const maxSize = 50
 
+// ExtremeGenerator is implemented by types that know how to generate their
+// own extreme values. If a type (or a pointer to it) implements this
+// interface, minValue, maxValue, and zeroValue call the corresponding
+// method instead of falling back to the scalar switch or quick.Value.
+// This mirrors the role that testing/quick's Generator interface plays
+// for quick.Value: it lets a domain type such as BoundedInt, Money, or
+// UUID contribute its own meaningful corner cases.
+//
+// A value-receiver implementation's methods should return a reflect.Value
+// of the receiver's own type; a pointer-receiver implementation's methods
+// may return either the pointee type or a pointer to it (e.g. &Money{...})
+// — both are handled correctly when the argument being generated is itself
+// a pointer to the implementing type.
+type ExtremeGenerator interface {
+	// MinValue returns a minimal value for the implementing type.
+	MinValue(rand *rand.Rand) reflect.Value
+	// MaxValue returns a maximal value for the implementing type.
+	MaxValue(rand *rand.Rand) reflect.Value
+	// ZeroValue returns a zero (or zero-adjacent) value for the implementing type.
+	ZeroValue(rand *rand.Rand) reflect.Value
+}
+
 type xValueType int
 const (
     xMin xValueType = iota      // minimum value
     xMax                        // maximum value
     xZero                       // zero value
+    xSpecial                    // IEEE 754 special value (NaN, ±Inf, -0, ...)
+    xNeighbour                  // value adjacent to a min/max/zero boundary
     xRnd                        // arbitrary value
 )
 
@@ -54,30 +78,119 @@ func rndZValueType(rnd *rand.Rand) zValueType {
     return zValueType(rnd.Int31n(int32(zZero+1)))
 }
 
+// ExtremeConfig controls how Values/ValuesWithConfig pick among extreme,
+// special, and random argument values, analogous to quick.Config.
+//
+// MinWeight, MaxWeight, ZeroWeight, SpecialWeight, NeighbourWeight, and
+// RandomWeight give the relative likelihood of picking minValue, maxValue,
+// zeroValue, specialValue, neighbourValue, and quick.Value respectively;
+// a weight of 0 disables that mode. If all six weights are 0, the weights
+// default to the uniform distribution that Values has always used.
+type ExtremeConfig struct {
+    MinWeight       int
+    MaxWeight       int
+    ZeroWeight      int
+    SpecialWeight   int
+    NeighbourWeight int
+    RandomWeight    int
+
+    // Seed, if non-zero, seeds a fresh *rand.Rand once per ValuesWithConfig
+    // call, shared and advanced across every subsequent generated call, so
+    // the whole sequence of picked values is reproducible.
+    Seed int64
+
+    // PerType overrides the weights above for specific argument types,
+    // so e.g. an int argument can always hit its min/max corners while a
+    // string argument stays uniformly random.
+    PerType map[reflect.Type]ExtremeConfig
+}
+
+// defaultExtremeConfig reproduces the uniform distribution Values has
+// always used: each of min, max, zero, special, neighbour, and random is
+// equally likely.
+var defaultExtremeConfig = ExtremeConfig{
+    MinWeight:       1,
+    MaxWeight:       1,
+    ZeroWeight:      1,
+    SpecialWeight:   1,
+    NeighbourWeight: 1,
+    RandomWeight:    1,
+}
 
-// ExtremeValues returns a function that returns either a random value or an extreme value
+// weightedValueType picks an xValueType according to cfg's weights, falling
+// back to the uniform rndValueType if no weight is set.
+func weightedValueType(cfg ExtremeConfig, rnd *rand.Rand) xValueType {
+    weights := []int{cfg.MinWeight, cfg.MaxWeight, cfg.ZeroWeight, cfg.SpecialWeight, cfg.NeighbourWeight, cfg.RandomWeight}
+    total := 0
+    for _, w := range weights {
+        total += w
+    }
+    if total <= 0 {
+        return rndValueType(rnd)
+    }
+    n := rnd.Intn(total)
+    for i, w := range weights {
+        if n < w {
+            return xValueType(i)
+        }
+        n -= w
+    }
+    return xRnd
+}
+
+// Values returns a function that returns either a random value or an
+// extreme value, using the default (uniform) ExtremeConfig.
 func Values(f interface{}) func([]reflect.Value, *rand.Rand) {
+    return ValuesWithConfig(f, nil)
+}
+
+// ValuesWithConfig is like Values but lets cfg control the weighting
+// between extreme, special, and random values, the random seed, and
+// per-argument-type overrides. A nil cfg behaves exactly like Values.
+func ValuesWithConfig(f interface{}, cfg *ExtremeConfig) func([]reflect.Value, *rand.Rand) {
     v := reflect.ValueOf(f)
     if v.Kind() != reflect.Func {
-        return nil        
+        return nil
+    }
+    c := defaultExtremeConfig
+    if cfg != nil {
+        c = *cfg
+    }
+    // Seed once, outside the returned closure, so the *rand.Rand advances
+    // across successive calls instead of resetting to the same state (and
+    // thus the same generated values) on every call.
+    var seeded *rand.Rand
+    if c.Seed != 0 {
+        seeded = rand.New(rand.NewSource(c.Seed))
     }
     g := func(a []reflect.Value, r *rand.Rand)  {
-        values(a, v.Type(), r)
+        if seeded != nil {
+            r = seeded
+        }
+        values(a, v.Type(), r, c)
     }
     return g
 }
 
 // values returns
-func values(args []reflect.Value, f reflect.Type, rand *rand.Rand) {
+func values(args []reflect.Value, f reflect.Type, rand *rand.Rand, cfg ExtremeConfig) {
     for j := 0; j < len(args); j++ {
         var ok bool
-        switch rndValueType(rand) {
+        argCfg := cfg
+        if c, found := cfg.PerType[f.In(j)]; found {
+            argCfg = c
+        }
+        switch weightedValueType(argCfg, rand) {
         case xMin:
-           args[j], ok = minValue(f.In(j), rand)
-        case xMax: 
-           args[j], ok = maxValue(f.In(j), rand)
+           args[j], ok = minValue(f.In(j), rand, newGenCtx())
+        case xMax:
+           args[j], ok = maxValue(f.In(j), rand, newGenCtx())
         case xZero:
-           args[j], ok = zeroValue(f.In(j), rand)
+           args[j], ok = zeroValue(f.In(j), rand, newGenCtx())
+        case xSpecial:
+           args[j], ok = specialValue(f.In(j), rand)
+        case xNeighbour:
+           args[j], ok = neighbourValue(f.In(j), rand)
         default:
            args[j], ok = quick.Value(f.In(j), rand)
         }
@@ -89,8 +202,238 @@ func values(args []reflect.Value, f reflect.Type, rand *rand.Rand) {
 }
 
 
+// extremeGeneratorOf returns the ExtremeGenerator implementation for t, trying
+// both the value receiver and the pointer receiver, or ok == false if t
+// implements neither.
+//
+// If t itself is a pointer type, probing reflect.Zero(t) directly would
+// yield a nil pointer; a value-receiver method promoted onto that nil
+// pointer's method set would then panic when called. So pointer types are
+// handled by probing t.Elem() instead and wrapping the result, which
+// always has a safe, non-nil receiver to call methods on.
+func extremeGeneratorOf(t reflect.Type) (g ExtremeGenerator, ok bool) {
+	if t.Kind() == reflect.Ptr {
+		eg, elemOk := extremeGeneratorOf(t.Elem())
+		if !elemOk {
+			return nil, false
+		}
+		return ptrGenerator{target: t, elem: eg}, true
+	}
+	if g, ok = reflect.Zero(t).Interface().(ExtremeGenerator); ok {
+		return g, true
+	}
+	if g, ok = reflect.New(t).Interface().(ExtremeGenerator); ok {
+		return g, true
+	}
+	return nil, false
+}
+
+// ptrGenerator adapts an ExtremeGenerator found on some type T into one for
+// *T, for when a function argument is itself a pointer to a generator type.
+// elem may have been probed via a value receiver (in which case its methods
+// return a T) or a pointer receiver that itself returns a *T; target lets
+// adaptPtr tell the two cases apart instead of assuming one and risking a
+// double pointer.
+type ptrGenerator struct {
+	target reflect.Type
+	elem   ExtremeGenerator
+}
+
+func (p ptrGenerator) MinValue(rand *rand.Rand) reflect.Value {
+	return adaptPtr(p.target, p.elem.MinValue(rand))
+}
+
+func (p ptrGenerator) MaxValue(rand *rand.Rand) reflect.Value {
+	return adaptPtr(p.target, p.elem.MaxValue(rand))
+}
+
+func (p ptrGenerator) ZeroValue(rand *rand.Rand) reflect.Value {
+	return adaptPtr(p.target, p.elem.ZeroValue(rand))
+}
+
+// adaptPtr normalizes v to the pointer type target: if v is already of type
+// target (the elem generator's pointer-receiver method returned itself),
+// it is returned unchanged; otherwise v is the pointee value (the elem
+// generator used a value receiver) and is wrapped in one pointer layer.
+func adaptPtr(target reflect.Type, v reflect.Value) reflect.Value {
+	if v.Type() == target {
+		return v
+	}
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	return p
+}
+
+// genFunc is the shape shared by minValue, maxValue, and zeroValue, so the
+// composite-type helpers below can recurse through whichever one is calling.
+type genFunc func(t reflect.Type, rand *rand.Rand, ctx *genCtx) (reflect.Value, bool)
+
+// genCtx tracks which types are currently being generated along the
+// current recursion path, so a self-referential type (e.g. a linked-list
+// Node containing a *Node field) can be detected and broken instead of
+// recursing forever.
+type genCtx struct {
+	inProgress map[reflect.Type]bool
+}
+
+func newGenCtx() *genCtx {
+	return &genCtx{inProgress: map[reflect.Type]bool{}}
+}
+
+// enter marks t as being generated and returns a function to unmark it once
+// generation of t completes. entered is false if t is already being
+// generated further up the current recursion path (a cycle), in which case
+// the caller must not recurse into t again.
+func (c *genCtx) enter(t reflect.Type) (exit func(), entered bool) {
+	if c.inProgress[t] {
+		return nil, false
+	}
+	c.inProgress[t] = true
+	return func() { delete(c.inProgress, t) }, true
+}
+
+// structValue builds a value of struct type t by generating each exported
+// field with gen (unexported fields are left at their zero value, as they
+// cannot be set via reflection).
+func structValue(t reflect.Type, rand *rand.Rand, ctx *genCtx, gen genFunc) (value reflect.Value, ok bool) {
+	v := reflect.New(t).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		fv, ok := gen(field.Type, rand, ctx)
+		if !ok {
+			return quick.Value(t, rand)
+		}
+		v.Field(i).Set(fv)
+	}
+	return v, true
+}
+
+// ptrValue builds a non-nil pointer to a value of type t.Elem() generated by gen.
+func ptrValue(t reflect.Type, rand *rand.Rand, ctx *genCtx, gen genFunc) (value reflect.Value, ok bool) {
+	ev, ok := gen(t.Elem(), rand, ctx)
+	if !ok {
+		return quick.Value(t, rand)
+	}
+	p := reflect.New(t.Elem())
+	p.Elem().Set(ev)
+	return p, true
+}
+
+// arrayValue builds a value of the fixed-size array type t, generating every
+// element with gen.
+func arrayValue(t reflect.Type, rand *rand.Rand, ctx *genCtx, gen genFunc) (value reflect.Value, ok bool) {
+	v := reflect.New(t).Elem()
+	for i := 0; i < t.Len(); i++ {
+		ev, ok := gen(t.Elem(), rand, ctx)
+		if !ok {
+			return quick.Value(t, rand)
+		}
+		v.Index(i).Set(ev)
+	}
+	return v, true
+}
+
+// sliceValue builds a slice of type t with n elements, each generated by gen.
+func sliceValue(t reflect.Type, rand *rand.Rand, ctx *genCtx, gen genFunc, n int) (value reflect.Value, ok bool) {
+	v := reflect.MakeSlice(t, n, n)
+	for i := 0; i < n; i++ {
+		ev, ok := gen(t.Elem(), rand, ctx)
+		if !ok {
+			return quick.Value(t, rand)
+		}
+		v.Index(i).Set(ev)
+	}
+	return v, true
+}
+
+// sizedSliceValue returns a length-1 slice or a length-maxSize slice with
+// equal probability, so both the single-element and capacity corners get
+// exercised.
+func sizedSliceValue(t reflect.Type, rand *rand.Rand, ctx *genCtx, gen genFunc) (value reflect.Value, ok bool) {
+	if rand.Intn(2) == 0 {
+		return sliceValue(t, rand, ctx, gen, 1)
+	}
+	return sliceValue(t, rand, ctx, gen, maxSize)
+}
+
+// mapValue builds a map of type t with n entries. For n == 1 the single key
+// is generated with gen too; for n > 1 keys are drawn from quick.Value so
+// that distinct entries don't collide, while values still use gen.
+//
+// For a key type with small cardinality (e.g. bool, or a small enum), the n
+// quick.Value draws can repeat and overwrite earlier entries, so the
+// resulting map may end up with fewer than n entries; the maxSize capacity
+// corner in sizedMapValue is therefore not guaranteed for such key types.
+func mapValue(t reflect.Type, rand *rand.Rand, ctx *genCtx, gen genFunc, n int) (value reflect.Value, ok bool) {
+	v := reflect.MakeMap(t)
+	for i := 0; i < n; i++ {
+		var key reflect.Value
+		var ok bool
+		if n == 1 {
+			key, ok = gen(t.Key(), rand, ctx)
+		} else {
+			key, ok = quick.Value(t.Key(), rand)
+		}
+		if !ok {
+			return quick.Value(t, rand)
+		}
+		val, ok := gen(t.Elem(), rand, ctx)
+		if !ok {
+			return quick.Value(t, rand)
+		}
+		v.SetMapIndex(key, val)
+	}
+	return v, true
+}
+
+// sizedMapValue returns a single-entry map or a maxSize-entry map with equal
+// probability, so both the single-element and capacity corners get exercised.
+func sizedMapValue(t reflect.Type, rand *rand.Rand, ctx *genCtx, gen genFunc) (value reflect.Value, ok bool) {
+	if rand.Intn(2) == 0 {
+		return mapValue(t, rand, ctx, gen, 1)
+	}
+	return mapValue(t, rand, ctx, gen, maxSize)
+}
+
+// minString returns a minimal-corner string: a lone NUL or replacement
+// character, an invalid UTF-8 byte sequence, or a byte-order mark.
+func minString(rand *rand.Rand) string {
+	switch rand.Intn(4) {
+	case 0:
+		return "\x00"
+	case 1:
+		return "\uFFFD"
+	case 2:
+		return string([]byte{0xff, 0xfe}) // invalid UTF-8
+	default:
+		return "\uFEFF" // byte-order mark
+	}
+}
+
+// maxString returns a long ASCII string of length maxSize.
+func maxString(rand *rand.Rand) string {
+	b := make([]byte, maxSize)
+	for i := range b {
+		b[i] = byte('A' + rand.Intn(26))
+	}
+	return string(b)
+}
+
 // minValue returns a minimal value of type t (or random value if t is non-scalar type)
-func minValue(t reflect.Type, rand *rand.Rand) (value reflect.Value, ok bool) {
+// Strings, structs, pointers, arrays, slices and maps are the exception: they
+// get minimal corner values too; interfaces, channels and funcs stay random.
+func minValue(t reflect.Type, rand *rand.Rand, ctx *genCtx) (value reflect.Value, ok bool) {
+	if g, ok := extremeGeneratorOf(t); ok {
+		return g.MinValue(rand), true
+	}
+	exit, entered := ctx.enter(t)
+	if !entered {
+		return quick.Value(t, rand)
+	}
+	defer exit()
 	v := reflect.New(t).Elem()
 	switch concrete := t; concrete.Kind() {
 	case reflect.Bool:
@@ -125,6 +468,18 @@ func minValue(t reflect.Type, rand *rand.Rand) (value reflect.Value, ok bool) {
 		v.SetUint(uint64(1))
 	case reflect.Uintptr:
 		v.SetUint(uint64(1))
+	case reflect.String:
+		v.SetString(minString(rand))
+	case reflect.Struct:
+		return structValue(t, rand, ctx, minValue)
+	case reflect.Ptr:
+		return ptrValue(t, rand, ctx, minValue)
+	case reflect.Array:
+		return arrayValue(t, rand, ctx, minValue)
+	case reflect.Slice:
+		return sizedSliceValue(t, rand, ctx, minValue)
+	case reflect.Map:
+		return sizedMapValue(t, rand, ctx, minValue)
 	default:
 		return quick.Value(t, rand)
 	}
@@ -134,7 +489,17 @@ func minValue(t reflect.Type, rand *rand.Rand) (value reflect.Value, ok bool) {
 
 
 // maxValue returns a maximum value of type t (or random value if t is non-scalar type)
-func maxValue(t reflect.Type, rand *rand.Rand) (value reflect.Value, ok bool) {
+// Strings, structs, pointers, arrays, slices and maps are the exception: they
+// get maximal corner values too; interfaces, channels and funcs stay random.
+func maxValue(t reflect.Type, rand *rand.Rand, ctx *genCtx) (value reflect.Value, ok bool) {
+	if g, ok := extremeGeneratorOf(t); ok {
+		return g.MaxValue(rand), true
+	}
+	exit, entered := ctx.enter(t)
+	if !entered {
+		return quick.Value(t, rand)
+	}
+	defer exit()
 	v := reflect.New(t).Elem()
 	switch concrete := t; concrete.Kind() {
 	case reflect.Bool:
@@ -169,6 +534,18 @@ func maxValue(t reflect.Type, rand *rand.Rand) (value reflect.Value, ok bool) {
 		v.SetUint(math.MaxUint64)
 	case reflect.Uintptr:
 		v.SetUint(math.MaxUint64)
+	case reflect.String:
+		v.SetString(maxString(rand))
+	case reflect.Struct:
+		return structValue(t, rand, ctx, maxValue)
+	case reflect.Ptr:
+		return ptrValue(t, rand, ctx, maxValue)
+	case reflect.Array:
+		return arrayValue(t, rand, ctx, maxValue)
+	case reflect.Slice:
+		return sizedSliceValue(t, rand, ctx, maxValue)
+	case reflect.Map:
+		return sizedMapValue(t, rand, ctx, maxValue)
 	default:
 		return quick.Value(t, rand)
 	}
@@ -178,7 +555,19 @@ func maxValue(t reflect.Type, rand *rand.Rand) (value reflect.Value, ok bool) {
 
 
 // zeroValue returns a zero value or a value close to zero type t (or random value if t is non-scalar type)
-func zeroValue(t reflect.Type, rand *rand.Rand) (value reflect.Value, ok bool) {
+// Strings, structs, pointers, arrays, slices and maps are the exception: strings,
+// slices and maps come back empty, pointers nil or pointing at a zero value, and
+// struct fields and array elements are zero-generated; interfaces, channels and
+// funcs stay random.
+func zeroValue(t reflect.Type, rand *rand.Rand, ctx *genCtx) (value reflect.Value, ok bool) {
+	if g, ok := extremeGeneratorOf(t); ok {
+		return g.ZeroValue(rand), true
+	}
+	exit, entered := ctx.enter(t)
+	if !entered {
+		return quick.Value(t, rand)
+	}
+	defer exit()
     zt := rndZValueType(rand)
 	v := reflect.New(t).Elem()
 	switch concrete := t; concrete.Kind() {
@@ -242,6 +631,152 @@ func zeroValue(t reflect.Type, rand *rand.Rand) (value reflect.Value, ok bool) {
 		v.SetUint(uint64(0))
 	case reflect.Uintptr:
 		v.SetUint(uint64(0))
+	case reflect.String:
+		v.SetString("")
+	case reflect.Struct:
+		return structValue(t, rand, ctx, zeroValue)
+	case reflect.Ptr:
+		if rand.Intn(2) == 0 {
+			return reflect.Zero(t), true
+		}
+		return ptrValue(t, rand, ctx, zeroValue)
+	case reflect.Array:
+		return arrayValue(t, rand, ctx, zeroValue)
+	case reflect.Slice:
+		return reflect.MakeSlice(t, 0, 0), true
+	case reflect.Map:
+		return reflect.MakeMap(t), true
+	default:
+		return quick.Value(t, rand)
+	}
+
+	return v, true
+}
+
+// float32Specials enumerates the IEEE 754 corner values for float32: NaN,
+// the two infinities, negative zero, the unit values, and the largest
+// integer that still round-trips exactly through a float32 (2^24).
+var float32Specials = []float32{
+	float32(math.NaN()),
+	float32(math.Inf(1)),
+	float32(math.Inf(-1)),
+	float32(math.Copysign(0, -1)),
+	1.0,
+	-1.0,
+	16777216, // 2^24
+}
+
+// float64Specials enumerates the IEEE 754 corner values for float64: NaN,
+// the two infinities, negative zero, the unit values, and the largest
+// integer that still round-trips exactly through a float64 (2^53).
+var float64Specials = []float64{
+	math.NaN(),
+	math.Inf(1),
+	math.Inf(-1),
+	math.Copysign(0, -1),
+	1.0,
+	-1.0,
+	9007199254740992, // 2^53
+}
+
+// specialValue returns an IEEE 754 special value for float and complex
+// kinds (or a random value via quick.Value for every other kind). For
+// complex kinds the real and imaginary parts are each drawn independently,
+// forming the cartesian product of the special values.
+func specialValue(t reflect.Type, rand *rand.Rand) (value reflect.Value, ok bool) {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Float32:
+		v.SetFloat(float64(float32Specials[rand.Intn(len(float32Specials))]))
+	case reflect.Float64:
+		v.SetFloat(float64Specials[rand.Intn(len(float64Specials))])
+	case reflect.Complex64:
+		re := float32Specials[rand.Intn(len(float32Specials))]
+		im := float32Specials[rand.Intn(len(float32Specials))]
+		v.SetComplex(complex128(complex(re, im)))
+	case reflect.Complex128:
+		re := float64Specials[rand.Intn(len(float64Specials))]
+		im := float64Specials[rand.Intn(len(float64Specials))]
+		v.SetComplex(complex(re, im))
+	default:
+		return quick.Value(t, rand)
+	}
+
+	return v, true
+}
+
+// intNeighbour uniformly picks one of the values adjacent to the signed
+// range [min, max]: {min, min+1, -1, 0, 1, max-1, max}.
+func intNeighbour(rand *rand.Rand, min, max int64) int64 {
+	choices := []int64{min, min + 1, -1, 0, 1, max - 1, max}
+	return choices[rand.Intn(len(choices))]
+}
+
+// uintNeighbour uniformly picks one of the values adjacent to the unsigned
+// range [0, max]: {0, 1, 2, max/2, max-1, max}.
+func uintNeighbour(rand *rand.Rand, max uint64) uint64 {
+	choices := []uint64{0, 1, 2, max / 2, max - 1, max}
+	return choices[rand.Intn(len(choices))]
+}
+
+// floatNeighbour32 uniformly picks one of the values adjacent to float32's
+// boundaries: {±MaxFloat32, ±MaxFloat32·(1-ε), ±1, ±0, ±SmallestNonzero, NaN}.
+func floatNeighbour32(rand *rand.Rand) float32 {
+	choices := []float32{
+		math.MaxFloat32, -math.MaxFloat32,
+		math.MaxFloat32 * (1 - 1e-7), -math.MaxFloat32 * (1 - 1e-7),
+		1, -1,
+		0, float32(math.Copysign(0, -1)),
+		math.SmallestNonzeroFloat32, -math.SmallestNonzeroFloat32,
+		float32(math.NaN()),
+	}
+	return choices[rand.Intn(len(choices))]
+}
+
+// floatNeighbour64 uniformly picks one of the values adjacent to float64's
+// boundaries: {±MaxFloat64, ±MaxFloat64·(1-ε), ±1, ±0, ±SmallestNonzero, NaN}.
+func floatNeighbour64(rand *rand.Rand) float64 {
+	choices := []float64{
+		math.MaxFloat64, -math.MaxFloat64,
+		math.MaxFloat64 * (1 - 1e-15), -math.MaxFloat64 * (1 - 1e-15),
+		1, -1,
+		0, math.Copysign(0, -1),
+		math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64,
+		math.NaN(),
+	}
+	return choices[rand.Intn(len(choices))]
+}
+
+// neighbourValue returns a value adjacent to a scalar min/max/zero boundary
+// of type t (or a random value via quick.Value if t is a non-scalar type),
+// composable with minValue/maxValue/zeroValue/specialValue via ExtremeConfig.
+func neighbourValue(t reflect.Type, rand *rand.Rand) (value reflect.Value, ok bool) {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Int8:
+		v.SetInt(intNeighbour(rand, math.MinInt8, math.MaxInt8))
+	case reflect.Int16:
+		v.SetInt(intNeighbour(rand, math.MinInt16, math.MaxInt16))
+	case reflect.Int32:
+		v.SetInt(intNeighbour(rand, math.MinInt32, math.MaxInt32))
+	case reflect.Int64, reflect.Int:
+		v.SetInt(intNeighbour(rand, math.MinInt64, math.MaxInt64))
+	case reflect.Uint8:
+		v.SetUint(uintNeighbour(rand, math.MaxUint8))
+	case reflect.Uint16:
+		v.SetUint(uintNeighbour(rand, math.MaxUint16))
+	case reflect.Uint32:
+		v.SetUint(uintNeighbour(rand, math.MaxUint32))
+	case reflect.Uint64, reflect.Uint, reflect.Uintptr:
+		v.SetUint(uintNeighbour(rand, math.MaxUint64))
+	case reflect.Float32:
+		v.SetFloat(float64(floatNeighbour32(rand)))
+	case reflect.Float64:
+		v.SetFloat(floatNeighbour64(rand))
+	case reflect.Complex64:
+		v.SetComplex(complex128(complex(floatNeighbour32(rand), floatNeighbour32(rand))))
+	case reflect.Complex128:
+		v.SetComplex(complex(floatNeighbour64(rand), floatNeighbour64(rand)))
 	default:
 		return quick.Value(t, rand)
 	}